@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package admin exposes node-operator endpoints that aren't part of any
+// chain's own API.
+package admin
+
+import (
+	"net/http"
+
+	"github.com/flare-foundation/flare/ids"
+	"github.com/flare-foundation/flare/utils/logging"
+	"github.com/flare-foundation/flare/vms/registry"
+)
+
+// Service is the admin API.
+type Service struct {
+	log        logging.Logger
+	vmRegistry registry.VMRegistry
+}
+
+// NewService returns an admin API service backed by vmRegistry.
+func NewService(log logging.Logger, vmRegistry registry.VMRegistry) *Service {
+	return &Service{
+		log:        log,
+		vmRegistry: vmRegistry,
+	}
+}
+
+// LoadVMsReply is the result of a LoadVMs call.
+type LoadVMsReply struct {
+	// NewVMs lists the VM IDs that were newly registered.
+	NewVMs []ids.ID `json:"newVMs"`
+	// Failed maps a discovered VM ID to the error registering it returned.
+	// A VM that fails after vms.Manager has recorded its factory stays
+	// registered, so later LoadVMs calls skip it.
+	Failed map[ids.ID]string `json:"failed"`
+}
+
+// LoadVMs re-scans the plugin directory and registers any VM installed
+// there since the node started, so an operator can add a VM to a running
+// node without a restart.
+func (s *Service) LoadVMs(_ *http.Request, _ *struct{}, reply *LoadVMsReply) error {
+	newVMs, failed, err := s.vmRegistry.Reload()
+	if err != nil {
+		return err
+	}
+
+	reply.NewVMs = newVMs
+	reply.Failed = make(map[ids.ID]string, len(failed))
+	for vmID, loadErr := range failed {
+		reply.Failed[vmID] = loadErr.Error()
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validators
+
+import "github.com/flare-foundation/flare/ids"
+
+// defaultHistoryWindow bounds how many historical validator-set snapshots a
+// manager retains when no explicit window is configured via
+// SetHistoryWindow.
+const defaultHistoryWindow = 1024
+
+// snapshot is a single cached validator set, keyed by the block it was
+// observed at.
+type snapshot struct {
+	height uint64
+	hash   ids.ID
+	set    Set
+}
+
+// history is a bounded, FIFO-evicted cache of validator-set snapshots keyed
+// by block ID, with a secondary index by height. It is a plain ring buffer
+// over a map rather than a full LRU: snapshots are written once when a block
+// is first observed (or fetched on a cache miss) and never touched again, so
+// recency of insertion is all the eviction policy needs.
+//
+// history is not safe for concurrent use; callers (manager) are expected to
+// hold their own lock.
+type history struct {
+	maxSize  int
+	byBlock  map[ids.ID]*snapshot
+	byHeight map[uint64]ids.ID
+	order    []ids.ID // insertion order, oldest first
+}
+
+func newHistory(maxSize int) *history {
+	if maxSize <= 0 {
+		maxSize = defaultHistoryWindow
+	}
+	return &history{
+		maxSize:  maxSize,
+		byBlock:  make(map[ids.ID]*snapshot),
+		byHeight: make(map[uint64]ids.ID),
+	}
+}
+
+func (h *history) getByBlock(blockID ids.ID) (*snapshot, bool) {
+	snap, ok := h.byBlock[blockID]
+	return snap, ok
+}
+
+func (h *history) getByHeight(height uint64) (*snapshot, bool) {
+	blockID, ok := h.byHeight[height]
+	if !ok {
+		return nil, false
+	}
+	return h.getByBlock(blockID)
+}
+
+// put records the validator set observed at blockID/height. If blockID is
+// already cached, put never clobbers its set or hash (so a fallback fetch
+// can't undo a snapshot already recorded by RegisterBlock), but it does
+// backfill the height index if the existing snapshot was only ever recorded
+// with height 0 - e.g. because it was first cached by the Source fallback
+// in GetValidatorsByBlockID, which doesn't know the block's height.
+func (h *history) put(blockID ids.ID, height uint64, hash ids.ID, set Set) {
+	if existing, exists := h.byBlock[blockID]; exists {
+		if existing.height == 0 && height > 0 {
+			existing.height = height
+			h.byHeight[height] = blockID
+		}
+		return
+	}
+	h.byBlock[blockID] = &snapshot{
+		height: height,
+		hash:   hash,
+		set:    set,
+	}
+	if height > 0 {
+		h.byHeight[height] = blockID
+	}
+	h.order = append(h.order, blockID)
+	h.evict()
+}
+
+func (h *history) evict() {
+	for len(h.order) > h.maxSize {
+		oldest := h.order[0]
+		h.order = h.order[1:]
+		if snap, ok := h.byBlock[oldest]; ok {
+			delete(h.byHeight, snap.height)
+		}
+		delete(h.byBlock, oldest)
+	}
+}
+
+// resize changes the retention window, evicting the oldest snapshots if the
+// new size is smaller than the current contents.
+func (h *history) resize(maxSize int) {
+	if maxSize <= 0 {
+		return
+	}
+	h.maxSize = maxSize
+	h.evict()
+}
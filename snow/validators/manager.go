@@ -4,12 +4,16 @@
 package validators
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/flare-foundation/flare/ids"
 	"github.com/flare-foundation/flare/utils/constants"
+	"github.com/flare-foundation/flare/utils/hashing"
+	"github.com/flare-foundation/flare/utils/wrappers"
 )
 
 // Manager holds the validator set of each subnet
@@ -22,9 +26,46 @@ type Manager interface {
 	// GetValidators returns the latest validator set.
 	GetValidators() (Set, error)
 
-	// GetValidatorsByBlockID returns the validator set
+	// GetValidatorsByBlockID returns the validator set that was active at
+	// blockID. It first consults the manager's history cache, then falls
+	// back to the configured Source on a miss, populating the cache for
+	// next time. The returned Set is an immutable snapshot with masked
+	// validators already removed, so it stays consistent with
+	// MaskValidator/RevealValidator no matter when it was taken.
 	GetValidatorsByBlockID(blockID ids.ID) (Set, error)
 
+	// GetValidatorsByHeight returns the validator set that was active at
+	// height, via the same history cache and Source fallback as
+	// GetValidatorsByBlockID. It only resolves heights the manager already
+	// has a blockID for (from RegisterBlock, or a prior
+	// GetValidatorsByBlockID call that has since been backfilled with its
+	// height); ErrNoValidators is returned otherwise.
+	GetValidatorsByHeight(height uint64) (Set, error)
+
+	// RegisterBlock notifies the manager that a new block was observed at
+	// height, so the validator set active at that point can be fetched from
+	// the configured Source and cached for future historical lookups. It is
+	// a no-op if no Source has been set.
+	//
+	// Nothing in this package calls RegisterBlock: it is the hook a
+	// component that actually observes new blocks (e.g. the consensus
+	// engine) is expected to call as each one is accepted. Until something
+	// does, history is populated lazily, one block at a time, by the
+	// Source fallback in GetValidatorsByBlockID.
+	RegisterBlock(blockID ids.ID, height uint64) error
+
+	// SetHistoryWindow configures how many historical blocks the manager
+	// retains validator-set snapshots for.
+	SetHistoryWindow(size int)
+
+	// RegisterCallback registers cb to be invoked, with the manager's
+	// current set version, whenever the live validator set changes (a
+	// SetSource call, or a MaskValidator/RevealValidator that actually
+	// changes masking state). Callers that cache anything derived from the
+	// validator set, such as the proposer windower's samplers, use this to
+	// invalidate those caches instead of polling.
+	RegisterCallback(cb func(setVersion uint64))
+
 	// MaskValidator hides the named validator from future samplings
 	MaskValidator(vdrID ids.ShortID) error
 
@@ -64,6 +105,7 @@ func NewManager(networkID uint32, withs ...With) Manager {
 	return &manager{
 		networkID:  networkID,
 		validators: validators,
+		history:    newHistory(defaultHistoryWindow),
 	}
 }
 
@@ -74,12 +116,38 @@ type manager struct {
 	validators Set
 	maskedVdrs ids.ShortSet
 	source     Source
+	history    *history
+
+	version   uint64
+	callbacks []func(setVersion uint64)
 }
 
 func (m *manager) SetSource(source Source) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	m.source = source
+	m.bumpVersion()
+}
+
+func (m *manager) RegisterCallback(cb func(setVersion uint64)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.callbacks = append(m.callbacks, cb)
+}
+
+// bumpVersion increments the set version and notifies every registered
+// callback. Callers must hold m.lock.
+func (m *manager) bumpVersion() {
+	m.version++
+	for _, cb := range m.callbacks {
+		cb(m.version)
+	}
+}
+
+func (m *manager) SetHistoryWindow(size int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.history.resize(size)
 }
 
 // GetValidatorSet implements the Manager interface.
@@ -96,10 +164,103 @@ func (m *manager) GetValidators() (Set, error) {
 func (m *manager) GetValidatorsByBlockID(blockID ids.ID) (Set, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
+
+	if snap, ok := m.history.getByBlock(blockID); ok {
+		return m.snapshotView(snap.set), nil
+	}
+
+	if m.source != nil {
+		set, err := m.source.GetByBlockID(blockID)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch validators for block %s: %w", blockID, err)
+		}
+		m.history.put(blockID, 0, canonicalHash(set), set)
+		return m.snapshotView(set), nil
+	}
+
 	if m.validators.Len() == 0 {
 		return nil, ErrNoValidators
 	}
-	return m.validators, nil
+	return m.snapshotView(m.validators), nil
+}
+
+// GetValidatorsByHeight implements the Manager interface.
+func (m *manager) GetValidatorsByHeight(height uint64) (Set, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if snap, ok := m.history.getByHeight(height); ok {
+		return m.snapshotView(snap.set), nil
+	}
+	return nil, ErrNoValidators
+}
+
+// RegisterBlock implements the Manager interface.
+func (m *manager) RegisterBlock(blockID ids.ID, height uint64) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.source == nil {
+		return nil
+	}
+
+	// blockID may already be cached with height 0, if GetValidatorsByBlockID
+	// had to fall back to Source for it before this block was ever
+	// registered. put backfills the height index in that case; for any
+	// other already-cached blockID it is a no-op, so there's no need to
+	// special-case "already cached" here.
+	if snap, ok := m.history.getByBlock(blockID); ok {
+		m.history.put(blockID, height, snap.hash, snap.set)
+		return nil
+	}
+
+	set, err := m.source.GetByBlockID(blockID)
+	if err != nil {
+		return fmt.Errorf("could not fetch validators for block %s at height %d: %w", blockID, height, err)
+	}
+	m.history.put(blockID, height, canonicalHash(set), set)
+	return nil
+}
+
+// snapshotView returns an immutable copy of set with every currently masked
+// validator removed, so a historical snapshot reflects the same masking
+// state as a live call to GetValidators would.
+func (m *manager) snapshotView(set Set) Set {
+	clone := NewSet()
+	for _, vdr := range set.List() {
+		if m.maskedVdrs.Contains(vdr.ID()) {
+			continue
+		}
+		_ = clone.AddWeight(vdr.ID(), vdr.Weight())
+	}
+	return clone
+}
+
+// canonicalHash returns a deterministic ID derived from the ID-sorted
+// contents of set. It is recorded alongside each historical snapshot, so an
+// unchanged validator set across consecutive blocks hashes the same; history
+// itself is keyed by block ID, and nothing in this package compares the hashes.
+func canonicalHash(set Set) ids.ID {
+	vdrs := set.List()
+	sort.Sort(validatorsByID(vdrs))
+
+	p := wrappers.Packer{Bytes: make([]byte, 0, len(vdrs)*(ids.ShortIDLen+wrappers.LongLen))}
+	for _, vdr := range vdrs {
+		id := vdr.ID()
+		p.PackFixedBytes(id[:])
+		p.PackLong(vdr.Weight())
+	}
+	return hashing.ComputeHash256Array(p.Bytes)
+}
+
+// validatorsByID sorts a slice of validators by ID, matching the canonical
+// ordering used elsewhere (e.g. proposer.windower) when sampling.
+type validatorsByID []Validator
+
+func (s validatorsByID) Len() int      { return len(s) }
+func (s validatorsByID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s validatorsByID) Less(i, j int) bool {
+	return bytes.Compare(s[i].ID().Bytes(), s[j].ID().Bytes()) < 0
 }
 
 // MaskValidator implements the Manager interface.
@@ -115,6 +276,7 @@ func (m *manager) MaskValidator(vdrID ids.ShortID) error {
 	if err := m.validators.MaskValidator(vdrID); err != nil {
 		return err
 	}
+	m.bumpVersion()
 	return nil
 }
 
@@ -131,6 +293,7 @@ func (m *manager) RevealValidator(vdrID ids.ShortID) error {
 	if err := m.validators.RevealValidator(vdrID); err != nil {
 		return err
 	}
+	m.bumpVersion()
 	return nil
 }
 
@@ -24,16 +24,26 @@ type Factory interface {
 	New(*snow.Context) (interface{}, error)
 }
 
+// StaticFactory may optionally be implemented by a Factory whose VM needs a
+// dedicated logger when only its static (chain-independent) API is being
+// constructed. This matters for VMs that run out-of-process (e.g.
+// rpcchainvm): they have no chain to borrow a logger from at that point, so
+// RegisterFactory gives them one explicitly instead of calling New(nil).
+type StaticFactory interface {
+	NewStatic(log logging.Logger) (interface{}, error)
+}
+
 // Manager is a VM manager.
 // It has the following functionality:
 //   1) Register a VM factory. To register a VM is to associate its ID with a
 //		 VMFactory which, when New() is called upon it, creates a new instance of that VM.
 //	 2) Get a VM factory. Given the ID of a VM that has been
 //      registered, return the factory that the ID is associated with.
-//   3) Manage the aliases of VMs
+//
+// Manager no longer owns VM aliasing itself; callers that only need to
+// resolve an alias (e.g. the chains/admin code) should depend on an
+// ids.Aliaser directly instead of pulling in the whole VM manager.
 type Manager interface {
-	ids.Aliaser
-
 	// Returns a factory that can create new instances of the VM
 	// with the given ID
 	GetFactory(ids.ID) (Factory, error)
@@ -48,9 +58,10 @@ type Manager interface {
 
 // Implements Manager
 type manager struct {
-	// Note: The string representation of a VM's ID is also considered to be an
-	// alias of the VM. That is, [VM].String() is an alias for the VM, too.
-	ids.Aliaser
+	// aliaser resolves a VM's registered aliases. Note: the string
+	// representation of a VM's ID is also considered to be an alias of the
+	// VM, i.e. [VM].String() is an alias for the VM, too.
+	aliaser ids.Aliaser
 
 	// Key: A VM's ID
 	// Value: A factory that creates new instances of that VM
@@ -67,10 +78,11 @@ type manager struct {
 	log logging.Logger
 }
 
-// NewManager returns an instance of a VM manager
-func NewManager(apiServer *server.Server, log logging.Logger) Manager {
+// NewManager returns an instance of a VM manager. aliaser is used to
+// register and resolve the aliases of the VMs this manager registers.
+func NewManager(apiServer *server.Server, log logging.Logger, aliaser ids.Aliaser) Manager {
 	return &manager{
-		Aliaser:   ids.NewAliaser(),
+		aliaser:   aliaser,
 		factories: make(map[ids.ID]Factory),
 		versions:  make(map[ids.ID]string),
 		apiServer: apiServer,
@@ -93,9 +105,7 @@ func (m *manager) RegisterFactory(vmID ids.ID, factory Factory) error {
 	if _, exists := m.factories[vmID]; exists {
 		return fmt.Errorf("%q was already registered as a vm", vmID)
 	}
-	fmt.Println("Alias getting called..")
-	if err := m.Alias(vmID, vmID.String()); err != nil {
-		fmt.Println("RegisterFactory error 1")
+	if err := m.aliaser.Alias(vmID, vmID.String()); err != nil {
 		return err
 	}
 
@@ -108,55 +118,32 @@ func (m *manager) RegisterFactory(vmID ids.ID, factory Factory) error {
 
 	m.log.Debug("adding static API for vm %q", vmID)
 
-	//vmsInterface, err := factory.New(nil)
-	//if err != nil {
-	//	return err
-	//}
-	vm, err := factory.New(nil)
+	var (
+		vm  interface{}
+		err error
+	)
+	if staticFactory, ok := factory.(StaticFactory); ok {
+		// The VM can't derive a logger from a chain's snow.Context because
+		// static-handler construction isn't bound to any chain, so give it
+		// one explicitly rather than calling New(nil).
+		vm, err = staticFactory.NewStatic(m.log)
+	} else {
+		vm, err = factory.New(nil)
+	}
 	if err != nil {
-		fmt.Println("RegisterFactory error 2")
 		return err
 	}
 
-	//var vm interface{}
-	//switch vmsInterface.(type) {
-	//case combinedvm.CombinedVM:
-	//	vms := (vmsInterface).(combinedvm.CombinedVM) // todo Put the combinedVM in some outer package to avoid circular dependency
-	//	vm = vms.Vm
-	//	//vm.Version()
-	//	//valVM := vms.VmVal
-	//	//fmt.Println("Calling GetValidators() in vms/manager")
-	//	//valVM.GetValidators(ids.ID{})
-	//default:
-	//	vm, err = factory.New(nil)
-	//	if err != nil {
-	//		return err
-	//	}
-	//}
-
-
-	//vms := (vmsInterface).(combinedvm.CombinedVM) // todo Put the combinedVM in some outer package to avoid circular dependency
-	//vm := vms.Vm
-	//
-	//valVM := vms.VmVal
-	//valVM.GetValidators(ids.ID{})
-
-	//commonVM, ok := vm.(common.VM)
-	//if !ok {
-	//	return nil
-	//}
 	switch vm.(type) {
-	case combinedvm.CombinedVM, *secp256k1fx.Fx, *nftfx.Fx, *propertyfx.Fx, []interface {}:
+	case combinedvm.CombinedVM, *secp256k1fx.Fx, *nftfx.Fx, *propertyfx.Fx, []interface{}:
 		return nil
 	}
 	commonVM := vm.(common.VM)
 	version, err := commonVM.Version()
 	if err != nil {
-		fmt.Println("RegisterFactory error 3")
 		m.log.Error("fetching version for %q errored with: %s", vmID, err)
 
 		if err := commonVM.Shutdown(); err != nil {
-			fmt.Println("RegisterFactory error 4")
 			return fmt.Errorf("shutting down VM errored with: %s", err)
 		}
 		return nil
@@ -165,7 +152,6 @@ func (m *manager) RegisterFactory(vmID ids.ID, factory Factory) error {
 
 	handlers, err := commonVM.CreateStaticHandlers()
 	if err != nil {
-		fmt.Println("RegisterFactory error 5")
 		m.log.Error("creating static API endpoints for %q errored with: %s", vmID, err)
 
 		if err := commonVM.Shutdown(); err != nil {
@@ -182,7 +168,6 @@ func (m *manager) RegisterFactory(vmID ids.ID, factory Factory) error {
 	for extension, service := range handlers {
 		m.log.Verbo("adding static API endpoint: %s%s", defaultEndpoint, extension)
 		if err := m.apiServer.AddRoute(service, lock, defaultEndpoint, extension, m.log); err != nil {
-			fmt.Println("RegisterFactory error 6")
 			return fmt.Errorf(
 				"failed to add static API endpoint %s%s: %s",
 				defaultEndpoint,
@@ -199,7 +184,7 @@ func (m *manager) RegisterFactory(vmID ids.ID, factory Factory) error {
 func (m *manager) Versions() (map[string]string, error) {
 	versions := make(map[string]string, len(m.versions))
 	for vmID, version := range m.versions {
-		alias, err := m.PrimaryAlias(vmID)
+		alias, err := m.aliaser.PrimaryAlias(vmID)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"github.com/flare-foundation/flare/ids"
+	"github.com/flare-foundation/flare/snow/validators"
+)
+
+// defaultSamplerCacheSize bounds how many parentID -> entries a windower
+// keeps around when no explicit size is configured.
+const defaultSamplerCacheSize = 256
+
+// cacheEntry holds the canonically sorted validators for a parentID's
+// validator set, and their weights in the same order. It deliberately does
+// not hold a sampler: WeightedWithoutReplacement is stateful across
+// Seed/Sample, and this entry is shared by every concurrent Delay call for
+// the same parentID, so each call builds its own sampler from these
+// immutable weights instead of fighting over one.
+type cacheEntry struct {
+	validators []validators.Validator
+	weights    []uint64
+}
+
+// samplerCache is a small FIFO-evicted cache of cacheEntry, keyed by
+// parentID. It is not safe for concurrent use; windower holds its own lock
+// around it.
+type samplerCache struct {
+	maxSize int
+	entries map[ids.ID]*cacheEntry
+	order   []ids.ID
+}
+
+func newSamplerCache(maxSize int) *samplerCache {
+	if maxSize <= 0 {
+		maxSize = defaultSamplerCacheSize
+	}
+	return &samplerCache{
+		maxSize: maxSize,
+		entries: make(map[ids.ID]*cacheEntry),
+	}
+}
+
+func (c *samplerCache) get(parentID ids.ID) (*cacheEntry, bool) {
+	entry, ok := c.entries[parentID]
+	return entry, ok
+}
+
+func (c *samplerCache) put(parentID ids.ID, entry *cacheEntry) {
+	if _, exists := c.entries[parentID]; exists {
+		return
+	}
+	c.entries[parentID] = entry
+	c.order = append(c.order, parentID)
+	for len(c.order) > c.maxSize {
+		oldest := c.order[0]
+		c.order = c.order[1:]
+		delete(c.entries, oldest)
+	}
+}
+
+// clear empties the cache. windower calls this whenever validators.Manager
+// reports the validator set changed, since every cached entry may now be
+// stale.
+func (c *samplerCache) clear() {
+	c.entries = make(map[ids.ID]*cacheEntry)
+	c.order = nil
+}
@@ -6,8 +6,11 @@ package proposer
 import (
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"github.com/flare-foundation/flare/ids"
 	"github.com/flare-foundation/flare/snow/validators"
 	"github.com/flare-foundation/flare/utils/math"
@@ -30,6 +33,16 @@ type Windower interface {
 		validatorID ids.ShortID,
 		parentID ids.ID,
 	) (time.Duration, error)
+
+	// CacheMetrics returns the sampler cache's lifetime hit and miss counts,
+	// so operators can confirm the cache is paying for itself as the
+	// validator set grows.
+	CacheMetrics() (hits, misses uint64)
+
+	// RegisterMetrics registers the sampler cache's hit/miss counters with
+	// registerer, so they're visible alongside the rest of the chain's
+	// metrics rather than only through CacheMetrics.
+	RegisterMetrics(registerer prometheus.Registerer) error
 }
 
 // windower interfaces with P-Chain and it is responsible for calculating the
@@ -38,17 +51,32 @@ type windower struct {
 	validators  validators.Manager
 	subnetID    ids.ID
 	chainSource uint64
-	sampler     sampler.WeightedWithoutReplacement
+
+	lock          sync.Mutex
+	cache         *samplerCache
+	hits          uint64
+	misses        uint64
+	hitsCounter   prometheus.Counter
+	missesCounter prometheus.Counter
 }
 
-func New(validators validators.Manager, subnetID, chainID ids.ID) Windower {
-	w := wrappers.Packer{Bytes: chainID[:]}
-	return &windower{
-		validators:  validators,
+func New(vdrs validators.Manager, subnetID, chainID ids.ID) Windower {
+	packer := wrappers.Packer{Bytes: chainID[:]}
+	w := &windower{
+		validators:  vdrs,
 		subnetID:    subnetID,
-		chainSource: w.UnpackLong(),
-		sampler:     sampler.NewDeterministicWeightedWithoutReplacement(),
+		chainSource: packer.UnpackLong(),
+		cache:       newSamplerCache(defaultSamplerCacheSize),
 	}
+	// The validator set backing any cached sampler may have changed, so drop
+	// every cached entry rather than try to figure out which ones are still
+	// valid.
+	vdrs.RegisterCallback(func(uint64) {
+		w.lock.Lock()
+		defer w.lock.Unlock()
+		w.cache.clear()
+	})
+	return w
 }
 
 func (w *windower) Delay(chainHeight uint64, validatorID ids.ShortID, parentID ids.ID) (time.Duration, error) {
@@ -56,53 +84,48 @@ func (w *windower) Delay(chainHeight uint64, validatorID ids.ShortID, parentID i
 		return MaxDelay, nil
 	}
 
-	// get the validator set by the p-chain height
-	validatorSet, err := w.validators.GetValidatorsByBlockID(parentID)
+	entry, err := w.entryFor(parentID)
 	if err != nil {
-		return 0, fmt.Errorf("could not get validators (block: %x): %w", parentID, err)
+		return 0, err
 	}
 
-	// convert the list of validators to a slice
-	validators := validatorSet.List()
 	weight := uint64(0)
-	for _, validator := range validators {
-		weight, err = math.Add64(weight, validator.Weight())
+	for _, vdrWeight := range entry.weights {
+		weight, err = math.Add64(weight, vdrWeight)
 		if err != nil {
 			return 0, err
 		}
 	}
 
-	// canonically sort validators
-	// Note: validators are sorted by ID, sorting by weight would not create a
-	// canonically sorted list
-	sort.Sort(validatorsSlice(validators))
-
-	// convert the slice of validators to a slice of weights
-	validatorWeights := make([]uint64, len(validators))
-	for i, validator := range validators {
-		validatorWeights[i] = validator.Weight()
-	}
-
-	if err := w.sampler.Initialize(validatorWeights); err != nil {
-		return 0, err
-	}
-
 	numToSample := MaxWindows
 	if weight < uint64(numToSample) {
 		numToSample = int(weight)
 	}
 
+	// entry is shared by every concurrent Delay call for this parentID, so a
+	// sampler - which carries RNG state across Seed/Sample - is built fresh
+	// here rather than reused from the cache. A cache hit therefore still
+	// pays Initialize's cost on every call, not just Seed+Sample: sharing one
+	// Initialize-once sampler across concurrent Delay calls for the same
+	// parentID would be a data race. What's cached (entry.weights) avoids
+	// redoing the O(n log n) canonical sort and the weight extraction on
+	// every call.
+	s := sampler.NewDeterministicWeightedWithoutReplacement()
+	if err := s.Initialize(entry.weights); err != nil {
+		return 0, err
+	}
+
 	seed := chainHeight ^ w.chainSource
-	w.sampler.Seed(int64(seed))
+	s.Seed(int64(seed))
 
-	indices, err := w.sampler.Sample(numToSample)
+	indices, err := s.Sample(numToSample)
 	if err != nil {
 		return 0, err
 	}
 
 	delay := time.Duration(0)
 	for _, index := range indices {
-		nodeID := validators[index].ID()
+		nodeID := entry.validators[index].ID()
 		if nodeID == validatorID {
 			return delay, nil
 		}
@@ -110,3 +133,89 @@ func (w *windower) Delay(chainHeight uint64, validatorID ids.ShortID, parentID i
 	}
 	return delay, nil
 }
+
+// entryFor returns the cached validators/weights for parentID, building and
+// caching them from the historical validator set at parentID on a miss.
+// Only a cache miss pays for the O(n log n) sort; a hit is a map lookup.
+//
+// The fetch from w.validators happens with w.lock released: GetValidatorsByBlockID
+// takes the validators.Manager's own lock, and that manager calls back into
+// registered callbacks (including this windower's cache-invalidation
+// callback, which takes w.lock) while still holding it. Holding w.lock
+// across the fetch would let one goroutine take w.lock then block on the
+// manager's lock while another holds the manager's lock and blocks on
+// w.lock - an AB-BA deadlock.
+func (w *windower) entryFor(parentID ids.ID) (*cacheEntry, error) {
+	w.lock.Lock()
+	entry, ok := w.cache.get(parentID)
+	if ok {
+		w.hits++
+		if w.hitsCounter != nil {
+			w.hitsCounter.Inc()
+		}
+	} else {
+		w.misses++
+		if w.missesCounter != nil {
+			w.missesCounter.Inc()
+		}
+	}
+	w.lock.Unlock()
+
+	if ok {
+		return entry, nil
+	}
+
+	// get the validator set by the p-chain height
+	validatorSet, err := w.validators.GetValidatorsByBlockID(parentID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get validators (block: %x): %w", parentID, err)
+	}
+
+	// convert the list of validators to a slice
+	vdrs := validatorSet.List()
+
+	// canonically sort validators
+	// Note: validators are sorted by ID, sorting by weight would not create a
+	// canonically sorted list
+	sort.Sort(validatorsSlice(vdrs))
+
+	// convert the slice of validators to a slice of weights
+	vdrWeights := make([]uint64, len(vdrs))
+	for i, validator := range vdrs {
+		vdrWeights[i] = validator.Weight()
+	}
+
+	entry = &cacheEntry{validators: vdrs, weights: vdrWeights}
+
+	w.lock.Lock()
+	w.cache.put(parentID, entry)
+	w.lock.Unlock()
+	return entry, nil
+}
+
+func (w *windower) CacheMetrics() (hits, misses uint64) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.hits, w.misses
+}
+
+func (w *windower) RegisterMetrics(registerer prometheus.Registerer) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.hitsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "proposervm_windower_sampler_cache_hits",
+		Help: "Number of times the proposer windower reused a cached sampler entry",
+	})
+	w.missesCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "proposervm_windower_sampler_cache_misses",
+		Help: "Number of times the proposer windower had to rebuild a sampler entry",
+	})
+	if err := registerer.Register(w.hitsCounter); err != nil {
+		return fmt.Errorf("couldn't register windower sampler cache hits counter: %w", err)
+	}
+	if err := registerer.Register(w.missesCounter); err != nil {
+		return fmt.Errorf("couldn't register windower sampler cache misses counter: %w", err)
+	}
+	return nil
+}
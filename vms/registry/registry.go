@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package registry lets new VM plugins be installed into a running node
+// without a restart: it diffs a plugin directory against the VMs a
+// vms.Manager already knows about and registers whatever is new.
+package registry
+
+import (
+	"github.com/flare-foundation/flare/ids"
+	"github.com/flare-foundation/flare/utils/logging"
+	"github.com/flare-foundation/flare/vms"
+	"github.com/flare-foundation/flare/vms/rpcchainvm"
+)
+
+var _ VMRegistry = &vmRegistry{}
+
+// VMRegistry discovers and installs VM plugins on demand.
+type VMRegistry interface {
+	// Reload re-scans the plugin directory and registers any VM found there
+	// that isn't already known to the manager. It never aborts the scan
+	// because one plugin failed to load: that plugin's error is reported in
+	// failed, and the scan continues with the rest.
+	Reload() (newVMs []ids.ID, failed map[ids.ID]error, err error)
+}
+
+// vmRegistry implements VMRegistry.
+type vmRegistry struct {
+	pluginDir string
+	manager   vms.Manager
+	log       logging.Logger
+}
+
+// NewVMRegistry returns a VMRegistry that installs plugins found under
+// pluginDir into manager.
+func NewVMRegistry(pluginDir string, manager vms.Manager, log logging.Logger) VMRegistry {
+	return &vmRegistry{
+		pluginDir: pluginDir,
+		manager:   manager,
+		log:       log,
+	}
+}
+
+func (r *vmRegistry) Reload() ([]ids.ID, map[ids.ID]error, error) {
+	discovered, err := rpcchainvm.Discover(r.pluginDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newVMs []ids.ID
+	failed := make(map[ids.ID]error)
+	for vmID, path := range discovered {
+		if _, err := r.manager.GetFactory(vmID); err == nil {
+			continue // already registered
+		}
+
+		factory := rpcchainvm.NewFactory(path)
+		if err := r.manager.RegisterFactory(vmID, factory); err != nil {
+			r.log.Error("failed to register vm %q from %q: %s", vmID, path, err)
+			failed[vmID] = err
+			continue
+		}
+		newVMs = append(newVMs, vmID)
+	}
+	return newVMs, failed, nil
+}
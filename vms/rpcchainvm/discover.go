@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/flare-foundation/flare/ids"
+	"github.com/flare-foundation/flare/utils/hashing"
+)
+
+// Discover scans pluginDir for executable plugin binaries and returns the VM
+// ID each one would register under, mapped to its path. The ID is derived
+// deterministically from the binary's filename, so the same binary always
+// maps to the same ID across restarts and across nodes.
+func Discover(pluginDir string) (map[ids.ID]string, error) {
+	entries, err := os.ReadDir(pluginDir)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read plugin dir %q: %w", pluginDir, err)
+	}
+
+	found := make(map[ids.ID]string, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't stat plugin %q: %w", entry.Name(), err)
+		}
+		if info.Mode()&0o111 == 0 {
+			continue // not executable
+		}
+
+		name := entry.Name()
+		vmID, err := ids.ToID(hashing.ComputeHash256([]byte(name)))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't derive vm ID for plugin %q: %w", name, err)
+		}
+		found[vmID] = filepath.Join(pluginDir, name)
+	}
+	return found, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package rpcchainvm lets a VM run as its own subprocess and be driven over
+// gRPC, instead of being linked into the node binary. A VM built this way
+// can be installed or upgraded independently of the node, and a VM crash no
+// longer takes the node process down with it.
+package rpcchainvm
+
+import (
+	"fmt"
+
+	"github.com/flare-foundation/flare/snow"
+	"github.com/flare-foundation/flare/utils/logging"
+	"github.com/flare-foundation/flare/vms"
+)
+
+var (
+	_ vms.Factory       = &Factory{}
+	_ vms.StaticFactory = &Factory{}
+)
+
+// Factory launches a VM plugin binary as a subprocess and returns a
+// common.VM proxy that talks to it over gRPC. It implements vms.Factory so
+// a plugin VM can be registered with vms.Manager exactly like an in-process
+// one.
+type Factory struct {
+	// Path is the plugin binary to execute.
+	Path string
+}
+
+// NewFactory returns a Factory that launches the plugin binary at path.
+func NewFactory(path string) *Factory {
+	return &Factory{Path: path}
+}
+
+// New launches the plugin and returns a VM proxy bound to ctx. Plugin
+// stdout/stderr is routed through ctx.Log, so output from a plugin instance
+// is attributed to the chain it serves rather than the global node log.
+func (f *Factory) New(ctx *snow.Context) (interface{}, error) {
+	var log logging.Logger = logging.NoLog{}
+	if ctx != nil {
+		log = ctx.Log
+	}
+	return f.newClient(log)
+}
+
+// NewStatic launches the plugin to serve only its static (chain-independent)
+// API. It exists so RegisterFactory can give the plugin a real logger for
+// this step instead of calling New(nil).
+func (f *Factory) NewStatic(log logging.Logger) (interface{}, error) {
+	return f.newClient(log)
+}
+
+func (f *Factory) newClient(log logging.Logger) (*VMClient, error) {
+	rt, err := newRuntime(f.Path, log)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't start plugin %q: %w", f.Path, err)
+	}
+
+	rpcClient, err := rt.client.Client()
+	if err != nil {
+		rt.Kill()
+		return nil, fmt.Errorf("couldn't dial plugin %q: %w", f.Path, err)
+	}
+
+	raw, err := rpcClient.Dispense(vmPluginName)
+	if err != nil {
+		rt.Kill()
+		return nil, fmt.Errorf("couldn't dispense vm from plugin %q: %w", f.Path, err)
+	}
+
+	conn, ok := raw.(*pluginConn)
+	if !ok {
+		rt.Kill()
+		return nil, fmt.Errorf("plugin %q returned unexpected connection type %T", f.Path, raw)
+	}
+
+	return NewVMClient(conn.conn, conn.broker, rt), nil
+}
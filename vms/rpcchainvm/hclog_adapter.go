@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"io"
+	"log"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/flare-foundation/flare/utils/logging"
+)
+
+// hcLogAdapter routes go-plugin's internal hclog output through a
+// chain-scoped logging.Logger, so a plugin's subprocess output shows up
+// under the chain it belongs to rather than under the node's global log.
+type hcLogAdapter struct {
+	log logging.Logger
+}
+
+func newHCLogAdapter(log logging.Logger) hclog.Logger {
+	return &hcLogAdapter{log: log}
+}
+
+func (a *hcLogAdapter) Trace(msg string, args ...interface{}) { a.log.Verbo(msg, args...) }
+func (a *hcLogAdapter) Debug(msg string, args ...interface{}) { a.log.Debug(msg, args...) }
+func (a *hcLogAdapter) Info(msg string, args ...interface{})  { a.log.Info(msg, args...) }
+func (a *hcLogAdapter) Warn(msg string, args ...interface{})  { a.log.Warn(msg, args...) }
+func (a *hcLogAdapter) Error(msg string, args ...interface{}) { a.log.Error(msg, args...) }
+
+func (a *hcLogAdapter) IsTrace() bool { return true }
+func (a *hcLogAdapter) IsDebug() bool { return true }
+func (a *hcLogAdapter) IsInfo() bool  { return true }
+func (a *hcLogAdapter) IsWarn() bool  { return true }
+func (a *hcLogAdapter) IsError() bool { return true }
+
+func (a *hcLogAdapter) With(_ ...interface{}) hclog.Logger    { return a }
+func (a *hcLogAdapter) Named(_ string) hclog.Logger           { return a }
+func (a *hcLogAdapter) ResetNamed(_ string) hclog.Logger      { return a }
+func (a *hcLogAdapter) SetLevel(_ hclog.Level)                {}
+func (a *hcLogAdapter) GetLevel() hclog.Level                 { return hclog.Debug }
+func (a *hcLogAdapter) Name() string                          { return "plugin" }
+func (a *hcLogAdapter) ImpliedArgs() []interface{}            { return nil }
+func (a *hcLogAdapter) Log(_ hclog.Level, msg string, args ...interface{}) {
+	a.log.Debug(msg, args...)
+}
+
+func (a *hcLogAdapter) StandardLogger(*hclog.StandardLoggerOptions) *log.Logger {
+	return log.New(io.Discard, "", 0)
+}
+
+func (a *hcLogAdapter) StandardWriter(*hclog.StandardLoggerOptions) io.Writer {
+	return io.Discard
+}
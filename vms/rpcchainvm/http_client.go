@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	"github.com/flare-foundation/flare/vms/rpcchainvm/vmproto"
+)
+
+// httpClient forwards an http.Handler call across the plugin boundary: it
+// serializes the request, sends it over the same gRPC connection as the
+// rest of the VM, and replays the response it gets back. This is what lets
+// CreateHandlers/CreateStaticHandlers return ordinary http.Handlers to the
+// node's API server even though the real handler lives in another process.
+type httpClient struct {
+	client vmproto.HTTPClient
+	prefix string
+}
+
+func newHTTPClient(conn *grpc.ClientConn, prefix string) http.Handler {
+	return &httpClient{
+		client: vmproto.NewHTTPClient(conn),
+		prefix: prefix,
+	}
+}
+
+func (h *httpClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := h.client.Serve(r.Context(), &vmproto.HTTPRequest{
+		Prefix: h.prefix,
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Header: toHeaderValues(r.Header),
+		Body:   body,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	for key, values := range resp.Header {
+		for _, value := range values.Values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(int(resp.Code))
+	_, _ = w.Write(resp.Body)
+}
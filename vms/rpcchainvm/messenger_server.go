@@ -0,0 +1,29 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+
+	"github.com/flare-foundation/flare/snow/engine/common"
+	"github.com/flare-foundation/flare/vms/rpcchainvm/vmproto"
+)
+
+var _ vmproto.MessengerServer = &messengerServer{}
+
+// messengerServer runs on the node side. The plugin dials back into it (via
+// the go-plugin broker) to deliver a common.Message whenever it wants to
+// notify the consensus engine, e.g. PendingTxs - the one thing a unary
+// Initialize call can't carry, since toEngine is written to at an arbitrary
+// later time, not once at startup.
+type messengerServer struct {
+	vmproto.UnimplementedMessengerServer
+
+	toEngine chan<- common.Message
+}
+
+func (s *messengerServer) Notify(_ context.Context, req *vmproto.NotifyRequest) (*vmproto.NotifyResponse, error) {
+	s.toEngine <- common.Message(req.Message)
+	return &vmproto.NotifyResponse{}, nil
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+
+	plugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	"github.com/flare-foundation/flare/snow/engine/common"
+	"github.com/flare-foundation/flare/vms/rpcchainvm/vmproto"
+)
+
+// grpcPlugin is the go-plugin glue that hands the node a *pluginConn on the
+// client side, and registers the real VM implementation against the gRPC
+// server on the plugin (server) side.
+type grpcPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	// vm is only set on the plugin side, by Serve.
+	vm common.VM
+}
+
+func (p *grpcPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	vmServer := NewVMServer(p.vm, broker)
+	vmproto.RegisterVMServer(s, vmServer)
+	vmproto.RegisterHTTPServer(s, vmServer)
+	return nil
+}
+
+// pluginConn bundles the dialed connection with the go-plugin broker that
+// created it. The broker is what lets VMClient open a second, reverse
+// connection the plugin dials back into - used to stream engine
+// notifications (e.g. PendingTxs) from the plugin back to the node, since a
+// plain unary gRPC call can't carry a value pushed onto toEngine at an
+// arbitrary later time.
+type pluginConn struct {
+	conn   *grpc.ClientConn
+	broker *plugin.GRPCBroker
+}
+
+func (p *grpcPlugin) GRPCClient(_ context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &pluginConn{conn: c, broker: broker}, nil
+}
+
+// Serve runs vm as a plugin, blocking until the node disconnects. A VM
+// that's built to run out-of-process should have a main() that does nothing
+// but call this.
+func Serve(vm common.VM) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: handshakeConfig,
+		Plugins: map[string]plugin.Plugin{
+			vmPluginName: &grpcPlugin{vm: vm},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"os/exec"
+
+	plugin "github.com/hashicorp/go-plugin"
+
+	"github.com/flare-foundation/flare/utils/logging"
+)
+
+// vmPluginName is the key the VM is dispensed under on the go-plugin map.
+// A plugin binary only ever serves one VM, so a single fixed name is enough.
+const vmPluginName = "vm"
+
+// handshakeConfig is the magic cookie a plugin binary must echo back before
+// the node will treat it as a VM plugin, so the node doesn't mistake an
+// unrelated subprocess for one.
+var handshakeConfig = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "FLARE_VM_PLUGIN",
+	MagicCookieValue: "songbird",
+}
+
+// runtime owns the subprocess backing a single VM plugin instance.
+type runtime struct {
+	client *plugin.Client
+}
+
+// newRuntime launches the plugin binary at path and negotiates the
+// handshake. Subprocess stdout/stderr is piped through log rather than the
+// node's global logger, so it is attributed to the chain the instance
+// serves (or to the static logger, for static-handler construction).
+func newRuntime(path string, log logging.Logger) (*runtime, error) {
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  handshakeConfig,
+		Plugins:          map[string]plugin.Plugin{vmPluginName: &grpcPlugin{}},
+		Cmd:              exec.Command(path),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Logger:           newHCLogAdapter(log),
+	})
+
+	// Dial eagerly so a plugin that fails to start is reported from
+	// Factory.New/NewStatic, not from the first RPC a caller happens to make.
+	if _, err := client.Client(); err != nil {
+		client.Kill()
+		return nil, err
+	}
+
+	return &runtime{client: client}, nil
+}
+
+// Kill terminates the plugin subprocess. It is safe to call more than once.
+func (r *runtime) Kill() {
+	r.client.Kill()
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"fmt"
+
+	plugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	"github.com/flare-foundation/flare/ids"
+	"github.com/flare-foundation/flare/snow"
+	"github.com/flare-foundation/flare/snow/engine/common"
+	"github.com/flare-foundation/flare/vms/rpcchainvm/vmproto"
+)
+
+var _ common.VM = &VMClient{}
+
+// VMClient is a common.VM proxy for a VM running in a plugin subprocess.
+// Every call is forwarded over gRPC to the real implementation; the rest of
+// the node only ever talks to this proxy, so it can't tell a plugin VM from
+// an in-process one.
+type VMClient struct {
+	client vmproto.VMClient
+	conn   *grpc.ClientConn
+	broker *plugin.GRPCBroker
+	rt     *runtime
+}
+
+// NewVMClient wraps conn as a common.VM. broker lets Initialize open a
+// reverse connection for engine notifications; rt is kept around so
+// Shutdown can tear down the backing subprocess.
+func NewVMClient(conn *grpc.ClientConn, broker *plugin.GRPCBroker, rt *runtime) *VMClient {
+	return &VMClient{
+		client: vmproto.NewVMClient(conn),
+		conn:   conn,
+		broker: broker,
+		rt:     rt,
+	}
+}
+
+// Initialize forwards to the plugin's Initialize, then drains the plugin's
+// engine notifications (e.g. PendingTxs) into toEngine for as long as the VM
+// runs: the plugin dials back into a messengerServer served over the
+// go-plugin broker and calls Notify for every message it would otherwise
+// have sent directly on toEngine in-process.
+//
+// fxs are not forwarded: an Fx is an in-process Go implementation, not data,
+// so it can't be serialized across the plugin boundary. A VM that runs
+// out-of-process must build its required Fxs in, the same way it builds in
+// everything else it needs that isn't plain bytes.
+func (vm *VMClient) Initialize(
+	ctx *snow.Context,
+	genesisBytes, upgradeBytes, configBytes []byte,
+	toEngine chan<- common.Message,
+	fxs []*common.Fx,
+) error {
+	engineServerID := vm.broker.NextId()
+	go vm.broker.AcceptAndServe(engineServerID, func(opts []grpc.ServerOption) *grpc.Server {
+		server := grpc.NewServer(opts...)
+		vmproto.RegisterMessengerServer(server, &messengerServer{toEngine: toEngine})
+		return server
+	})
+
+	_, err := vm.client.Initialize(context.Background(), &vmproto.InitializeRequest{
+		GenesisBytes:   genesisBytes,
+		UpgradeBytes:   upgradeBytes,
+		ConfigBytes:    configBytes,
+		EngineServerId: engineServerID,
+	})
+	return err
+}
+
+func (vm *VMClient) Bootstrapping() error {
+	_, err := vm.client.Bootstrapping(context.Background(), &vmproto.BootstrappingRequest{})
+	return err
+}
+
+func (vm *VMClient) Bootstrapped() error {
+	_, err := vm.client.Bootstrapped(context.Background(), &vmproto.BootstrappedRequest{})
+	return err
+}
+
+// Shutdown tears down the remote VM and, regardless of whether that RPC
+// succeeds, kills the subprocess so its resources are freed rather than leak
+// a half-dead plugin. Neither Shutdown nor vms.Manager starts a replacement
+// instance afterwards.
+func (vm *VMClient) Shutdown() error {
+	_, rpcErr := vm.client.Shutdown(context.Background(), &vmproto.ShutdownRequest{})
+	vm.conn.Close()
+	vm.rt.Kill()
+	return rpcErr
+}
+
+func (vm *VMClient) Version() (string, error) {
+	resp, err := vm.client.Version(context.Background(), &vmproto.VersionRequest{})
+	if err != nil {
+		return "", err
+	}
+	return resp.Version, nil
+}
+
+func (vm *VMClient) CreateHandlers() (map[string]*common.HTTPHandler, error) {
+	resp, err := vm.client.CreateHandlers(context.Background(), &vmproto.CreateHandlersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return vm.toHandlers(resp.Handlers)
+}
+
+func (vm *VMClient) CreateStaticHandlers() (map[string]*common.HTTPHandler, error) {
+	resp, err := vm.client.CreateStaticHandlers(context.Background(), &vmproto.CreateStaticHandlersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return vm.toHandlers(resp.Handlers)
+}
+
+func (vm *VMClient) toHandlers(descs []*vmproto.Handler) (map[string]*common.HTTPHandler, error) {
+	handlers := make(map[string]*common.HTTPHandler, len(descs))
+	for _, desc := range descs {
+		handlers[desc.Prefix] = &common.HTTPHandler{
+			LockOptions: common.LockOptions(desc.LockOptions),
+			Handler:     newHTTPClient(vm.conn, desc.Prefix),
+		}
+	}
+	return handlers, nil
+}
+
+func (vm *VMClient) HealthCheck() (interface{}, error) {
+	resp, err := vm.client.HealthCheck(context.Background(), &vmproto.HealthCheckRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("health check failed: %w", err)
+	}
+	return resp.Details, nil
+}
+
+func (vm *VMClient) Connected(nodeID ids.ShortID) error {
+	_, err := vm.client.Connected(context.Background(), &vmproto.ConnectedRequest{NodeID: nodeID[:]})
+	return err
+}
+
+func (vm *VMClient) Disconnected(nodeID ids.ShortID) error {
+	_, err := vm.client.Disconnected(context.Background(), &vmproto.DisconnectedRequest{NodeID: nodeID[:]})
+	return err
+}
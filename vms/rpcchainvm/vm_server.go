@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	plugin "github.com/hashicorp/go-plugin"
+
+	"github.com/flare-foundation/flare/snow/engine/common"
+	"github.com/flare-foundation/flare/vms/rpcchainvm/vmproto"
+)
+
+var (
+	_ vmproto.VMServer   = &VMServer{}
+	_ vmproto.HTTPServer = &VMServer{}
+)
+
+// VMServer runs on the plugin side: it receives gRPC calls from VMClient and
+// forwards them into the real, in-process VM implementation.
+type VMServer struct {
+	vmproto.UnimplementedVMServer
+
+	vm       common.VM
+	broker   *plugin.GRPCBroker
+	handlers map[string]*common.HTTPHandler
+}
+
+// NewVMServer wraps vm so it can be served over gRPC by Serve. broker is
+// used by Initialize to dial back into the node's engine messenger.
+func NewVMServer(vm common.VM, broker *plugin.GRPCBroker) *VMServer {
+	return &VMServer{vm: vm, broker: broker}
+}
+
+// Initialize dials back into the messengerServer VMClient stood up at
+// req.EngineServerId, then gives the in-process vm a toEngine channel backed
+// by that connection: every message the vm writes to it is relayed to the
+// node via Notify, instead of being dropped on a channel nobody drains.
+func (s *VMServer) Initialize(_ context.Context, req *vmproto.InitializeRequest) (*vmproto.InitializeResponse, error) {
+	conn, err := s.broker.Dial(req.EngineServerId)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't dial engine messenger: %w", err)
+	}
+	messenger := vmproto.NewMessengerClient(conn)
+
+	toEngine := make(chan common.Message)
+	go func() {
+		for msg := range toEngine {
+			if _, err := messenger.Notify(context.Background(), &vmproto.NotifyRequest{
+				Message: uint32(msg),
+			}); err != nil {
+				return
+			}
+		}
+	}()
+
+	if err := s.vm.Initialize(nil, req.GenesisBytes, req.UpgradeBytes, req.ConfigBytes, toEngine, nil); err != nil {
+		return nil, err
+	}
+	return &vmproto.InitializeResponse{}, nil
+}
+
+func (s *VMServer) Bootstrapping(context.Context, *vmproto.BootstrappingRequest) (*vmproto.BootstrappingResponse, error) {
+	return &vmproto.BootstrappingResponse{}, s.vm.Bootstrapping()
+}
+
+func (s *VMServer) Bootstrapped(context.Context, *vmproto.BootstrappedRequest) (*vmproto.BootstrappedResponse, error) {
+	return &vmproto.BootstrappedResponse{}, s.vm.Bootstrapped()
+}
+
+func (s *VMServer) Shutdown(context.Context, *vmproto.ShutdownRequest) (*vmproto.ShutdownResponse, error) {
+	return &vmproto.ShutdownResponse{}, s.vm.Shutdown()
+}
+
+func (s *VMServer) Version(context.Context, *vmproto.VersionRequest) (*vmproto.VersionResponse, error) {
+	version, err := s.vm.Version()
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.VersionResponse{Version: version}, nil
+}
+
+func (s *VMServer) CreateHandlers(context.Context, *vmproto.CreateHandlersRequest) (*vmproto.CreateHandlersResponse, error) {
+	handlers, err := s.vm.CreateHandlers()
+	if err != nil {
+		return nil, err
+	}
+	s.trackHandlers(handlers)
+	return &vmproto.CreateHandlersResponse{Handlers: toHandlerDescs(handlers)}, nil
+}
+
+func (s *VMServer) CreateStaticHandlers(context.Context, *vmproto.CreateStaticHandlersRequest) (*vmproto.CreateStaticHandlersResponse, error) {
+	handlers, err := s.vm.CreateStaticHandlers()
+	if err != nil {
+		return nil, err
+	}
+	s.trackHandlers(handlers)
+	return &vmproto.CreateStaticHandlersResponse{Handlers: toHandlerDescs(handlers)}, nil
+}
+
+func (s *VMServer) trackHandlers(handlers map[string]*common.HTTPHandler) {
+	if s.handlers == nil {
+		s.handlers = make(map[string]*common.HTTPHandler, len(handlers))
+	}
+	for prefix, handler := range handlers {
+		s.handlers[prefix] = handler
+	}
+}
+
+// Serve implements vmproto.HTTPServer: it replays an HTTP request forwarded
+// by httpClient against the real handler registered under req.Prefix.
+func (s *VMServer) Serve(_ context.Context, req *vmproto.HTTPRequest) (*vmproto.HTTPResponse, error) {
+	handler, ok := s.handlers[req.Prefix]
+	if !ok {
+		return nil, fmt.Errorf("no handler registered for prefix %q", req.Prefix)
+	}
+
+	url := req.Path
+	if req.Query != "" {
+		url += "?" + req.Query
+	}
+	httpReq, err := http.NewRequest(req.Method, url, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range req.Header {
+		for _, value := range values.Values {
+			httpReq.Header.Add(key, value)
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.Handler.ServeHTTP(recorder, httpReq)
+
+	return &vmproto.HTTPResponse{
+		Code:   int32(recorder.Code),
+		Header: toHeaderValues(recorder.Header()),
+		Body:   recorder.Body.Bytes(),
+	}, nil
+}
+
+// toHeaderValues converts an http.Header into the map[string]*vmproto.Values
+// shape HTTPResponse/HTTPRequest carry their headers in, since a proto map
+// can't hold a repeated field directly as its value type.
+func toHeaderValues(header http.Header) map[string]*vmproto.Values {
+	values := make(map[string]*vmproto.Values, len(header))
+	for key, vals := range header {
+		values[key] = &vmproto.Values{Values: vals}
+	}
+	return values
+}
+
+func toHandlerDescs(handlers map[string]*common.HTTPHandler) []*vmproto.Handler {
+	descs := make([]*vmproto.Handler, 0, len(handlers))
+	for prefix, handler := range handlers {
+		descs = append(descs, &vmproto.Handler{
+			Prefix:      prefix,
+			LockOptions: uint32(handler.LockOptions),
+		})
+	}
+	return descs
+}